@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// SignerSetTxCheckpoint resolves the checkpoint validators must sign for
+// sstx, using whichever CheckpointEncoding the chain's current params
+// select for the height sstx was created at, so a tx created before
+// EIP712StartHeight keeps its legacy ABI checkpoint after the switch. This
+// is the production caller of SignerSetTx.GetCheckpointForParams, and
+// therefore of the EIP-712 path for txs created at or after a chain's
+// EIP712StartHeight.
+func (k Keeper) SignerSetTxCheckpoint(ctx sdk.Context, sstx types.SignerSetTx) ([]byte, error) {
+	return sstx.GetCheckpointForParams(k.GravityID(ctx), k.GetParams(ctx), sstx.Height)
+}
+
+// BatchTxCheckpoint resolves the checkpoint validators must sign for batch.
+// See SignerSetTxCheckpoint.
+func (k Keeper) BatchTxCheckpoint(ctx sdk.Context, batch types.BatchTx) ([]byte, error) {
+	return batch.GetCheckpointForParams(k.GravityID(ctx), k.GetParams(ctx), batch.Height)
+}
+
+// ContractCallTxCheckpoint resolves the checkpoint validators must sign
+// for call. See SignerSetTxCheckpoint.
+func (k Keeper) ContractCallTxCheckpoint(ctx sdk.Context, call types.ContractCallTx) ([]byte, error) {
+	return call.GetCheckpointForParams(k.GravityID(ctx), k.GetParams(ctx), call.Height)
+}
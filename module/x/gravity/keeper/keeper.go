@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// store key prefixes for the slice of module state this package models:
+// params and the chain's GravityID. The full keeper carries many more
+// prefixes (signer sets, batches, attestations, ...) that aren't needed to
+// resolve a checkpoint and are not reproduced here.
+var (
+	paramsMaxLogicCallPayloadBytesKey = []byte{0x01}
+	paramsCheckpointEncodingKey       = []byte{0x02}
+	paramsEIP712StartHeightKey        = []byte{0x03}
+	paramsEthChainIDKey               = []byte{0x04}
+	gravityIDKey                      = []byte{0x05}
+)
+
+// Keeper manages gravity module state. Only the slice needed to resolve
+// and enforce OutgoingTx checkpoints is modeled here.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+}
+
+func NewKeeper(storeKey storetypes.StoreKey) Keeper {
+	return Keeper{storeKey: storeKey}
+}
+
+// GetParams returns the module's current checkpoint-related params,
+// falling back to types.DefaultParams for any key that has never been set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	params := types.DefaultParams()
+
+	if bz := store.Get(paramsMaxLogicCallPayloadBytesKey); bz != nil {
+		params.MaxLogicCallPayloadBytes = sdk.BigEndianToUint64(bz)
+	}
+	if bz := store.Get(paramsCheckpointEncodingKey); bz != nil {
+		params.CheckpointEncoding = types.CheckpointEncoding(sdk.BigEndianToUint64(bz))
+	}
+	if bz := store.Get(paramsEIP712StartHeightKey); bz != nil {
+		params.EIP712StartHeight = sdk.BigEndianToUint64(bz)
+	}
+	if bz := store.Get(paramsEthChainIDKey); bz != nil {
+		params.EthChainID = sdk.BigEndianToUint64(bz)
+	}
+
+	return params
+}
+
+// SetParams persists the module's checkpoint-related params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(paramsMaxLogicCallPayloadBytesKey, sdk.Uint64ToBigEndian(params.MaxLogicCallPayloadBytes))
+	store.Set(paramsCheckpointEncodingKey, sdk.Uint64ToBigEndian(uint64(params.CheckpointEncoding)))
+	store.Set(paramsEIP712StartHeightKey, sdk.Uint64ToBigEndian(params.EIP712StartHeight))
+	store.Set(paramsEthChainIDKey, sdk.Uint64ToBigEndian(params.EthChainID))
+}
+
+// GravityID returns this chain's configured GravityID, which binds
+// checkpoints (and the EIP-712 domain's verifyingContract) to a single
+// Gravity deployment so they can't be replayed against another.
+func (k Keeper) GravityID(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(gravityIDKey)
+}
+
+// SetGravityID sets this chain's configured GravityID.
+func (k Keeper) SetGravityID(ctx sdk.Context, gravityID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(gravityIDKey, gravityID)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// CreateContractCallTx enforces per-chain limits on a new outgoing logic
+// call before it is handed off to be stored and queued for attestation.
+// Payload size is checked here, against the chain's current
+// MaxLogicCallPayloadBytes param, rather than inside ContractCallTx
+// itself, since the bound is configurable per-chain rather than a fixed
+// constant.
+func (k Keeper) CreateContractCallTx(ctx sdk.Context, call types.ContractCallTx) error {
+	params := k.GetParams(ctx)
+	if err := call.ValidatePayloadSize(params.MaxLogicCallPayloadBytes); err != nil {
+		return sdkerrors.Wrap(err, "logic call")
+	}
+	return nil
+}
+
+// CreateMultiContractCallTx is the MultiContractCallTx analogue of
+// CreateContractCallTx, enforcing MaxLogicCallPayloadBytes against every
+// sub-call.
+func (k Keeper) CreateMultiContractCallTx(ctx sdk.Context, multi types.MultiContractCallTx) error {
+	params := k.GetParams(ctx)
+	if err := multi.ValidatePayloadSize(params.MaxLogicCallPayloadBytes); err != nil {
+		return sdkerrors.Wrap(err, "multi contract call")
+	}
+	return nil
+}
+
+// SubmitLogicCall handles a MsgSubmitLogicCall, the actual msg-handler
+// entry point for a new outgoing logic call. This is the real caller
+// CreateContractCallTx's payload-size check needed: without a handler
+// invoking it, MaxLogicCallPayloadBytes was never enforced against a
+// logic call actually submitted by an orchestrator.
+func (k Keeper) SubmitLogicCall(ctx sdk.Context, msg *types.MsgSubmitLogicCall) error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(err, "orchestrator")
+	}
+	return k.CreateContractCallTx(ctx, msg.LogicCall)
+}
+
+// SubmitMultiLogicCall is the MsgSubmitMultiLogicCall analogue of
+// SubmitLogicCall.
+func (k Keeper) SubmitMultiLogicCall(ctx sdk.Context, msg *types.MsgSubmitMultiLogicCall) error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(err, "orchestrator")
+	}
+	return k.CreateMultiContractCallTx(ctx, msg.MultiLogicCall)
+}
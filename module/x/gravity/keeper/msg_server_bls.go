@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+var (
+	// blsShareKeyPrefix stores one validator's raw BLS signature share,
+	// keyed by (nonce, orchestrator), so a resubmission for the same
+	// SignerSetTx is rejected rather than double-counted.
+	blsShareKeyPrefix = []byte{0x10}
+	// blsAggregateKeyPrefix stores the rolling aggregate of every share
+	// seen so far for a nonce, keyed by nonce.
+	blsAggregateKeyPrefix = []byte{0x11}
+	// blsPubkeyKeyPrefix stores each orchestrator's registered compressed
+	// BLS12-381 G1 pubkey, keyed by orchestrator. SubmitBlsSignature looks
+	// this up to verify a share before it is folded into the aggregate, so
+	// an orchestrator must register a pubkey before its shares are
+	// accepted.
+	blsPubkeyKeyPrefix = []byte{0x12}
+)
+
+func blsShareKey(nonce uint64, orchestrator sdk.AccAddress) []byte {
+	key := sdk.Uint64ToBigEndian(nonce)
+	return append(key, orchestrator.Bytes()...)
+}
+
+func blsAggregateKey(nonce uint64) []byte {
+	return sdk.Uint64ToBigEndian(nonce)
+}
+
+// SetOrchestratorBlsPubkey registers orchestrator's compressed BLS12-381
+// G1 pubkey, which SubmitBlsSignature verifies its future shares against.
+func (k Keeper) SetOrchestratorBlsPubkey(ctx sdk.Context, orchestrator sdk.AccAddress, pubkey []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blsPubkeyKeyPrefix)
+	store.Set(orchestrator.Bytes(), pubkey)
+}
+
+// GetOrchestratorBlsPubkey returns orchestrator's registered BLS pubkey,
+// if any.
+func (k Keeper) GetOrchestratorBlsPubkey(ctx sdk.Context, orchestrator sdk.AccAddress) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blsPubkeyKeyPrefix)
+	bz := store.Get(orchestrator.Bytes())
+	return bz, bz != nil
+}
+
+// SubmitBlsSignature handles a MsgSubmitBlsSignature: it verifies
+// orchestrator's share against its registered BLS pubkey, records the
+// share for msg.Nonce, then recomputes and stores the rolling aggregate of
+// every verified share seen so far for that nonce. Whether the current
+// aggregate is backed by enough validator power to treat as final is
+// decided by whoever reads GetBlsAggregateSignature against the
+// SignerSetTx's Threshold; this keeper only tracks share bookkeeping and
+// aggregation, not validator power.
+//
+// Verifying each share before it is folded into the aggregate matters:
+// AggregateBlsShares only checks that a share is some valid G2 point, not
+// that it is orchestrator's signature over the right message, so without
+// this check a single orchestrator could poison a nonce's aggregate with
+// an arbitrary on-curve "share" that can never be removed.
+func (k Keeper) SubmitBlsSignature(ctx sdk.Context, msg *types.MsgSubmitBlsSignature) error {
+	orchestrator, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "orchestrator")
+	}
+
+	pubkey, found := k.GetOrchestratorBlsPubkey(ctx, orchestrator)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "orchestrator %s has not registered a BLS pubkey", msg.Orchestrator)
+	}
+
+	messageHash := types.BlsSignatureMessageHash(k.GravityID(ctx), msg.Checkpoint, msg.Nonce)
+	verified, err := types.VerifyBlsShare(pubkey, messageHash, msg.BlsSignature)
+	if err != nil {
+		return sdkerrors.Wrap(err, "verifying BLS signature share")
+	}
+	if !verified {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "orchestrator %s submitted a BLS share that does not verify against its registered pubkey", msg.Orchestrator)
+	}
+
+	shareStore := prefix.NewStore(ctx.KVStore(k.storeKey), blsShareKeyPrefix)
+	key := blsShareKey(msg.Nonce, orchestrator)
+	if shareStore.Has(key) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "orchestrator %s already submitted a BLS share for nonce %d", msg.Orchestrator, msg.Nonce)
+	}
+	shareStore.Set(key, msg.BlsSignature)
+
+	aggregate, err := types.AggregateBlsShares(k.getBlsShares(ctx, msg.Nonce))
+	if err != nil {
+		return sdkerrors.Wrap(err, "aggregating BLS shares")
+	}
+	k.setBlsAggregateSignature(ctx, msg.Nonce, aggregate)
+	return nil
+}
+
+func (k Keeper) getBlsShares(ctx sdk.Context, nonce uint64) [][]byte {
+	shareStore := prefix.NewStore(ctx.KVStore(k.storeKey), blsShareKeyPrefix)
+	nonceBz := sdk.Uint64ToBigEndian(nonce)
+
+	var shares [][]byte
+	iterator := sdk.KVStorePrefixIterator(shareStore, nonceBz)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		shares = append(shares, iterator.Value())
+	}
+	return shares
+}
+
+func (k Keeper) setBlsAggregateSignature(ctx sdk.Context, nonce uint64, aggregate []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blsAggregateKeyPrefix)
+	store.Set(blsAggregateKey(nonce), aggregate)
+}
+
+// GetBlsAggregateSignature returns the current rolling aggregate BLS
+// signature for nonce, if at least one share has been submitted for it.
+func (k Keeper) GetBlsAggregateSignature(ctx sdk.Context, nonce uint64) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blsAggregateKeyPrefix)
+	bz := store.Get(blsAggregateKey(nonce))
+	return bz, bz != nil
+}
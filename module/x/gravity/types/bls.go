@@ -0,0 +1,238 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types/checkpointabi"
+)
+
+const (
+	// SignerSetTxBLSPrefixByte is the prefix byte for signer sets carrying
+	// the BLS aggregate-signature extension (AggregateBlsPubkey, Threshold).
+	// GetCheckpointBls does not pack it into the checkpoint: the BLS
+	// checkpoint is told apart from the plain secp256k1 one by its
+	// "checkpoint-bls" method salt alone.
+	SignerSetTxBLSPrefixByte = SignerSetTxPrefixByte + 0x10
+
+	// blsPubkeyLength is the byte length of a compressed BLS12-381 G1
+	// public key. Keys live in G1 and signatures in G2 (the
+	// "minimal-pubkey-size" variant also used by Ethereum's consensus
+	// layer), so that aggregating many validators' pubkeys into
+	// AggregateBlsPubkey is cheaper than aggregating the signatures would
+	// be the other way around.
+	blsPubkeyLength = 48
+	// BlsSignatureLength is the byte length of a compressed BLS12-381 G2
+	// aggregate signature.
+	BlsSignatureLength = 96
+)
+
+// HasBlsAggregate reports whether this SignerSetTx carries a BLS aggregate
+// public key and should be checkpointed with GetCheckpointBls rather than
+// the plain secp256k1 GetCheckpoint.
+func (u SignerSetTx) HasBlsAggregate() bool {
+	return len(u.AggregateBlsPubkey) > 0
+}
+
+// GetCheckpointBls packs the SignerSetTx checkpoint including the
+// aggregate BLS public key and signing threshold, salted with
+// "checkpoint-bls" instead of GetCheckpoint's "checkpoint" so a signature
+// over this digest can never be replayed as valid against the plain
+// secp256k1 checkpoint, or vice versa.
+func (u SignerSetTx) GetCheckpointBls(gravityID []byte) ([]byte, error) {
+	if err := u.validateCheckpointInputs(); err != nil {
+		return nil, fmt.Errorf("signer set: %w", err)
+	}
+	if _, err := bls12381.NewG1().FromCompressed(u.AggregateBlsPubkey); err != nil {
+		return nil, fmt.Errorf("aggregate BLS pubkey: %w", err)
+	}
+
+	gravityIDFixed, err := byteArrayToFixByteArray(gravityID)
+	if err != nil {
+		return nil, err
+	}
+
+	versionBytes := []uint8("checkpoint-bls")
+	var version [32]uint8
+	copy(version[:], versionBytes[:])
+
+	memberAddresses := make([]gethcommon.Address, len(u.Signers))
+	convertedPowers := make([]*big.Int, len(u.Signers))
+	for i, m := range u.Signers {
+		memberAddresses[i] = gethcommon.HexToAddress(m.EthereumAddress)
+		convertedPowers[i] = big.NewInt(int64(m.Power))
+	}
+
+	packed, err := checkpointabi.PackSignerSetBlsCheckpoint(
+		gravityIDFixed,
+		version,
+		big.NewInt(int64(u.Nonce)),
+		memberAddresses,
+		convertedPowers,
+		u.AggregateBlsPubkey,
+		big.NewInt(int64(u.Threshold)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Keccak256(packed), nil
+}
+
+// BlsSignatureMessageHash derives the message hash that validators' BLS key
+// shares sign over for a given checkpoint:
+//
+//	messageHash = hashToG2(gravityID || checkpoint || nonce)
+//
+// hashToG2 is the RFC 9380 hash-to-curve mapping onto BLS12-381 G2 that the
+// Solidity verifier runs against this same preimage via the EIP-2537
+// precompiles, so both sides must agree byte-for-byte on what is hashed:
+//
+//	function verify(checkpoint, nonce, aggPubkey, aggSig) returns (bool) {
+//	    msgPoint  = hashToG2(abi.encodePacked(gravityID, checkpoint, nonce))
+//	    // e(sig, G1) == e(msgPoint, aggPubkey)
+//	    return pairing(aggSig, NEG_G1, msgPoint, aggPubkey)
+//	}
+//
+// The final, authoritative hash-to-curve step is performed on the Solidity
+// side; this function only produces the preimage bytes fed into it.
+// VerifyBlsShare below runs the same pairing check off-chain, against
+// go-ethereum's own hash-to-curve, as a staging check before a share is
+// ever folded into a stored aggregate.
+func BlsSignatureMessageHash(gravityID, checkpoint []byte, nonce uint64) []byte {
+	nonceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonceBytes, nonce)
+
+	preimage := make([]byte, 0, len(gravityID)+len(checkpoint)+len(nonceBytes))
+	preimage = append(preimage, gravityID...)
+	preimage = append(preimage, checkpoint...)
+	preimage = append(preimage, nonceBytes...)
+	return preimage
+}
+
+// VerifyBlsShare reports whether share is orchestrator's BLS12-381
+// signature, under pubkey, over messageHash (as produced by
+// BlsSignatureMessageHash). It checks the pairing equation
+//
+//	e(pubkey, hashToG2(messageHash)) == e(G1, share)
+//
+// so that SubmitBlsSignature can reject a share before it is ever folded
+// into a nonce's stored aggregate, rather than only checking that a share
+// is some valid G2 point.
+func VerifyBlsShare(pubkey, messageHash, share []byte) (bool, error) {
+	g1 := bls12381.NewG1()
+	pubkeyPoint, err := g1.FromCompressed(pubkey)
+	if err != nil {
+		return false, fmt.Errorf("bls pubkey: %w", err)
+	}
+
+	g2 := bls12381.NewG2()
+	sharePoint, err := g2.FromCompressed(share)
+	if err != nil {
+		return false, fmt.Errorf("bls signature share: %w", err)
+	}
+	msgPoint := g2.MapToCurve(messageHash)
+
+	engine := bls12381.NewPairingEngine()
+	engine.AddPair(pubkeyPoint, msgPoint)
+	engine.AddPairInv(g1.One(), sharePoint)
+	return engine.Check(), nil
+}
+
+// MsgSubmitBlsSignature is submitted by a validator's orchestrator to
+// contribute its BLS key share toward the aggregate signature over a
+// SignerSetTx checkpoint. The keeper combines every verified share received
+// for the same nonce into a single BlsSignatureLength-byte aggregate,
+// updated after each share; it does not check the signers' power against
+// the SignerSetTx's Threshold.
+type MsgSubmitBlsSignature struct {
+	Nonce        uint64 `json:"nonce"`
+	Checkpoint   []byte `json:"checkpoint"`
+	BlsSignature []byte `json:"bls_signature"`
+	Orchestrator string `json:"orchestrator"`
+}
+
+const TypeMsgSubmitBlsSignature = "submit_bls_signature"
+
+func NewMsgSubmitBlsSignature(nonce uint64, checkpoint []byte, blsSignature []byte, orchestrator sdk.AccAddress) *MsgSubmitBlsSignature {
+	return &MsgSubmitBlsSignature{
+		Nonce:        nonce,
+		Checkpoint:   checkpoint,
+		BlsSignature: blsSignature,
+		Orchestrator: orchestrator.String(),
+	}
+}
+
+func (msg *MsgSubmitBlsSignature) Route() string { return RouterKey }
+
+func (msg *MsgSubmitBlsSignature) Type() string { return TypeMsgSubmitBlsSignature }
+
+func (msg *MsgSubmitBlsSignature) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(err, "orchestrator")
+	}
+	if len(msg.Checkpoint) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "checkpoint cannot be empty")
+	}
+	if len(msg.BlsSignature) != blsSignatureShareLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bls signature share must be %d bytes, got %d", blsSignatureShareLength, len(msg.BlsSignature))
+	}
+	return nil
+}
+
+func (msg *MsgSubmitBlsSignature) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgSubmitBlsSignature) GetSigners() []sdk.AccAddress {
+	orchestrator, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{orchestrator}
+}
+
+// blsSignatureShareLength is the byte length of a single validator's
+// BLS12-381 G2 signature share, compressed, before aggregation. It matches
+// BlsSignatureLength since aggregation (EC point addition) does not change
+// a compressed point's size.
+const blsSignatureShareLength = BlsSignatureLength
+
+// AggregateBlsShares combines per-validator BLS signature shares collected
+// via MsgSubmitBlsSignature into a single aggregate signature over the
+// given checkpoint. It does not weigh the shares by power or check any
+// Threshold. BLS12-381 G2 signature aggregation is plain
+// elliptic-curve point addition:
+//
+//	aggSig = shares[0] + shares[1] + ... + shares[n-1]   (EC point addition)
+//
+// using go-ethereum's bls12381 package, the same backend the EIP-2537
+// precompiles wrap, so the result matches what the Solidity verifier would
+// compute from the same shares.
+func AggregateBlsShares(shares [][]byte) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no BLS signature shares to aggregate")
+	}
+
+	g2 := bls12381.NewG2()
+	agg := g2.Zero()
+	for i, share := range shares {
+		if len(share) != blsSignatureShareLength {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "share %d: expected %d bytes, got %d", i, blsSignatureShareLength, len(share))
+		}
+		point, err := g2.FromCompressed(share)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "share %d: invalid G2 point: %v", i, err)
+		}
+		g2.Add(agg, agg, point)
+	}
+
+	return g2.ToCompressed(agg), nil
+}
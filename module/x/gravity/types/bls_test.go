@@ -0,0 +1,49 @@
+package types
+
+import "testing"
+
+func TestAggregateBlsSharesRejectsEmpty(t *testing.T) {
+	if _, err := AggregateBlsShares(nil); err == nil {
+		t.Fatal("expected an error aggregating zero shares")
+	}
+}
+
+func TestAggregateBlsSharesRejectsWrongLength(t *testing.T) {
+	shares := [][]byte{make([]byte, BlsSignatureLength-1)}
+	if _, err := AggregateBlsShares(shares); err == nil {
+		t.Fatal("expected an error for a share of the wrong length")
+	}
+}
+
+func TestGetCheckpointBlsRejectsWrongPubkeyLength(t *testing.T) {
+	sstx := SignerSetTx{
+		Nonce: 1,
+		Signers: []*BridgeValidator{
+			signer("0x1111111111111111111111111111111111111111", 100),
+		},
+		AggregateBlsPubkey: make([]byte, blsPubkeyLength-1),
+	}
+
+	if _, err := sstx.GetCheckpointBls([]byte("gravity-test")); err == nil {
+		t.Fatal("expected an error for an aggregate pubkey of the wrong length")
+	}
+}
+
+func TestMsgSubmitBlsSignatureValidateBasicRejectsWrongSignatureLength(t *testing.T) {
+	msg := NewMsgSubmitBlsSignatureForTest(1, []byte("checkpoint"), make([]byte, BlsSignatureLength-1))
+	if err := msg.ValidateBasic(); err == nil {
+		t.Fatal("expected an error for a BLS signature of the wrong length")
+	}
+}
+
+// NewMsgSubmitBlsSignatureForTest builds a MsgSubmitBlsSignature with a
+// fixed, valid bech32 orchestrator address (the all-zero account), so
+// ValidateBasic's signature-length check can be exercised in isolation.
+func NewMsgSubmitBlsSignatureForTest(nonce uint64, checkpoint, blsSignature []byte) *MsgSubmitBlsSignature {
+	return &MsgSubmitBlsSignature{
+		Nonce:        nonce,
+		Checkpoint:   checkpoint,
+		BlsSignature: blsSignature,
+		Orchestrator: "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a",
+	}
+}
@@ -0,0 +1,165 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types/checkpointabi"
+)
+
+// benchSigners builds n signers satisfying validateCheckpointInputs:
+// descending power, ascending address tiebreak, no duplicates or zero
+// addresses.
+func benchSigners(n int) []*BridgeValidator {
+	signers := make([]*BridgeValidator, n)
+	for i := 0; i < n; i++ {
+		signers[i] = &BridgeValidator{
+			EthereumAddress: gethcommon.BigToAddress(big.NewInt(int64(n - i))).Hex(),
+			Power:           uint64(n - i),
+		}
+	}
+	return signers
+}
+
+// benchTransactions builds n BatchTx transactions satisfying
+// validateCheckpointInputs: distinct IDs, nonzero amounts.
+func benchTransactions(n int) []*OutgoingTransferTx {
+	txs := make([]*OutgoingTransferTx, n)
+	for i := 0; i < n; i++ {
+		txs[i] = &OutgoingTransferTx{
+			Id:                uint64(i + 1),
+			EthereumRecipient: gethcommon.BigToAddress(big.NewInt(int64(i + 1))).Hex(),
+			Erc20Token:        ERC20Token{Contract: gethcommon.BigToAddress(big.NewInt(1)).Hex(), Amount: sdk.NewInt(100)},
+			Erc20Fee:          ERC20Token{Contract: gethcommon.BigToAddress(big.NewInt(1)).Hex(), Amount: sdk.NewInt(1)},
+		}
+	}
+	return txs
+}
+
+// BenchmarkSignerSetTxGetCheckpoint exercises a 150-signer SignerSetTx
+// checkpoint through the full GetCheckpoint path (validation + pre-compiled
+// ABI packing + hashing), demonstrating that checkpointabi's
+// abi.Arguments bindings avoid the per-call abi.JSON parse the legacy code
+// used to do.
+func BenchmarkSignerSetTxGetCheckpoint(b *testing.B) {
+	sstx := SignerSetTx{Nonce: 1, Signers: benchSigners(150)}
+	gravityID := []byte("gravity-bench")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := sstx.GetCheckpoint(gravityID); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkBatchTxGetCheckpoint exercises a 100-transaction BatchTx
+// checkpoint through the full GetCheckpoint path.
+func BenchmarkBatchTxGetCheckpoint(b *testing.B) {
+	batch := BatchTx{
+		BatchNonce:    1,
+		TokenContract: gethcommon.BigToAddress(big.NewInt(1)).Hex(),
+		Transactions:  benchTransactions(100),
+	}
+	gravityID := []byte("gravity-bench")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := batch.GetCheckpoint(gravityID); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkPackSignerSetCheckpoint isolates checkpointabi's packing step
+// (no validation, no hashing) for a 150-signer set, the shape relayer
+// verification and slashing evidence replay run in tight loops.
+func BenchmarkPackSignerSetCheckpoint(b *testing.B) {
+	const n = 150
+	addresses := make([]gethcommon.Address, n)
+	powers := make([]*big.Int, n)
+	for i := 0; i < n; i++ {
+		addresses[i] = gethcommon.BigToAddress(big.NewInt(int64(i + 1)))
+		powers[i] = big.NewInt(int64(n - i))
+	}
+	var gravityID, methodName [32]byte
+	copy(methodName[:], []byte("checkpoint"))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := checkpointabi.PackSignerSetCheckpoint(gravityID, methodName, big.NewInt(1), addresses, powers); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkPackBatchCheckpoint isolates checkpointabi's packing step for a
+// 100-transaction batch.
+func BenchmarkPackBatchCheckpoint(b *testing.B) {
+	const n = 100
+	amounts := make([]*big.Int, n)
+	destinations := make([]gethcommon.Address, n)
+	fees := make([]*big.Int, n)
+	for i := 0; i < n; i++ {
+		amounts[i] = big.NewInt(100)
+		destinations[i] = gethcommon.BigToAddress(big.NewInt(int64(i + 1)))
+		fees[i] = big.NewInt(1)
+	}
+	var gravityID, methodName [32]byte
+	copy(methodName[:], []byte("transactionBatch"))
+	tokenContract := gethcommon.BigToAddress(big.NewInt(1))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := checkpointabi.PackBatchCheckpoint(gravityID, methodName, amounts, destinations, fees, big.NewInt(1), tokenContract, big.NewInt(1000)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkMultiContractCallTxGetCheckpoint exercises the Merkle checkpoint
+// path over 100 sub-calls, each with a single token and no fees.
+func BenchmarkMultiContractCallTxGetCheckpoint(b *testing.B) {
+	const n = 100
+	subCalls := make([]ContractCallTx, n)
+	for i := 0; i < n; i++ {
+		subCalls[i] = ContractCallTx{
+			Address: gethcommon.BigToAddress(big.NewInt(int64(i + 1))).Hex(),
+			Tokens: []ERC20Token{
+				{Contract: gethcommon.BigToAddress(big.NewInt(int64(i + 1))).Hex(), Amount: sdk.NewInt(1)},
+			},
+			InvalidationScope: gethcommon.BigToHash(big.NewInt(int64(i + 1))),
+			InvalidationNonce: uint64(i + 1),
+			Timeout:           1000,
+		}
+	}
+	multi := MultiContractCallTx{SubCalls: subCalls}
+	gravityID := []byte("gravity-bench")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := multi.GetCheckpoint(gravityID); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestBenchHelpersProduceValidCheckpointInputs(t *testing.T) {
+	sstx := SignerSetTx{Nonce: 1, Signers: benchSigners(150)}
+	if err := sstx.validateCheckpointInputs(); err != nil {
+		t.Fatalf("benchSigners produced an invalid signer set: %v", err)
+	}
+
+	batch := BatchTx{Transactions: benchTransactions(100)}
+	if err := batch.validateCheckpointInputs(); err != nil {
+		t.Fatalf("benchTransactions produced an invalid batch: %v", err)
+	}
+}
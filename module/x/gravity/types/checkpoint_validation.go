@@ -0,0 +1,89 @@
+package types
+
+import (
+	"bytes"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// validateCheckpointInputs enforces a single canonical ordering for a
+// SignerSetTx's signers before it is packed into a checkpoint: signers must
+// be sorted by descending power with a lexicographic address tiebreak, and
+// no Ethereum address may be zero or repeated. Without this, two validators
+// could otherwise receive the "same" logical signer set in different
+// orders (or with a malformed address silently zero-padded by
+// HexToAddress) and sign different checkpoints for it, forking consensus
+// on what the outgoing tx actually attests to.
+func (u SignerSetTx) validateCheckpointInputs() error {
+	var prevAddr gethcommon.Address
+	seen := make(map[gethcommon.Address]struct{}, len(u.Signers))
+	for i, m := range u.Signers {
+		addr := gethcommon.HexToAddress(m.EthereumAddress)
+		if addr == (gethcommon.Address{}) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "signer %d: zero Ethereum address", i)
+		}
+		if _, ok := seen[addr]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate Ethereum address %s", addr.Hex())
+		}
+		seen[addr] = struct{}{}
+
+		if i > 0 {
+			prev := u.Signers[i-1]
+			switch {
+			case m.Power > prev.Power:
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "signers not sorted by descending power at index %d", i)
+			case m.Power == prev.Power && bytes.Compare(addr.Bytes(), prevAddr.Bytes()) <= 0:
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "signers with equal power not sorted by ascending address at index %d", i)
+			}
+		}
+		prevAddr = addr
+	}
+	return nil
+}
+
+// validateCheckpointInputs rejects duplicate transaction IDs and
+// zero-amount transfers before a BatchTx is packed into a checkpoint. Two
+// batches that differ only in the order or multiplicity of a duplicated
+// transaction would otherwise hash to different checkpoints for what
+// orchestrators intended as the same outgoing batch.
+func (b BatchTx) validateCheckpointInputs() error {
+	seen := make(map[uint64]struct{}, len(b.Transactions))
+	for i, tx := range b.Transactions {
+		if _, ok := seen[tx.Id]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate transaction id %d in batch", tx.Id)
+		}
+		seen[tx.Id] = struct{}{}
+
+		if tx.Erc20Token.Amount.IsZero() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "transaction %d: zero amount", i)
+		}
+	}
+	return nil
+}
+
+// validateCheckpointInputs rejects duplicate token contracts within a
+// ContractCallTx's Tokens and Fees so two validators cannot disagree on
+// which duplicated entry "counts" when the logic call executes on
+// Ethereum.
+func (c ContractCallTx) validateCheckpointInputs() error {
+	if err := requireUniqueContracts(c.Tokens); err != nil {
+		return sdkerrors.Wrap(err, "tokens")
+	}
+	if err := requireUniqueContracts(c.Fees); err != nil {
+		return sdkerrors.Wrap(err, "fees")
+	}
+	return nil
+}
+
+func requireUniqueContracts(coins []ERC20Token) error {
+	seen := make(map[gethcommon.Address]struct{}, len(coins))
+	for _, coin := range coins {
+		addr := gethcommon.HexToAddress(coin.Contract)
+		if _, ok := seen[addr]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate token contract %s", addr.Hex())
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+)
+
+func signer(addr string, power uint64) *BridgeValidator {
+	return &BridgeValidator{EthereumAddress: addr, Power: power}
+}
+
+func TestSignerSetTxValidateCheckpointInputsOrdering(t *testing.T) {
+	// Lower-case hex addresses that checksum (EIP-55) to mixed case with
+	// an uppercase letter sorting before a lowercase one: comparing
+	// addr.Hex() strings would reject this descending-power-equal-tiebreak
+	// ordering even though it is sorted correctly by address value.
+	const (
+		lower = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" // checksums to 0xbBbB...
+		upper = "0xcccccccccccccccccccccccccccccccccccccccc" // checksums to 0xCcCC...
+	)
+
+	sstx := SignerSetTx{
+		Signers: []*BridgeValidator{
+			signer(lower, 100),
+			signer(upper, 100),
+		},
+	}
+
+	if err := sstx.validateCheckpointInputs(); err != nil {
+		t.Fatalf("expected byte-value ordered signers to validate, got: %v", err)
+	}
+}
+
+func TestSignerSetTxValidateCheckpointInputsRejectsDuplicate(t *testing.T) {
+	addr := "0x1111111111111111111111111111111111111111"
+	sstx := SignerSetTx{
+		Signers: []*BridgeValidator{
+			signer(addr, 100),
+			signer(addr, 50),
+		},
+	}
+
+	if err := sstx.validateCheckpointInputs(); err == nil {
+		t.Fatal("expected duplicate Ethereum address to be rejected")
+	}
+}
+
+func TestSignerSetTxValidateCheckpointInputsRejectsZeroAddress(t *testing.T) {
+	sstx := SignerSetTx{
+		Signers: []*BridgeValidator{
+			signer("0x0000000000000000000000000000000000000000", 100),
+		},
+	}
+
+	if err := sstx.validateCheckpointInputs(); err == nil {
+		t.Fatal("expected zero Ethereum address to be rejected")
+	}
+}
+
+func TestSignerSetTxValidateCheckpointInputsRejectsDescendingPowerViolation(t *testing.T) {
+	sstx := SignerSetTx{
+		Signers: []*BridgeValidator{
+			signer("0x1111111111111111111111111111111111111111", 50),
+			signer("0x2222222222222222222222222222222222222222", 100),
+		},
+	}
+
+	if err := sstx.validateCheckpointInputs(); err == nil {
+		t.Fatal("expected out-of-order powers to be rejected")
+	}
+}
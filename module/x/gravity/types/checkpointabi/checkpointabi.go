@@ -0,0 +1,130 @@
+// Package checkpointabi provides pre-compiled ABI packing for Gravity
+// OutgoingTx checkpoints. The argument layouts below mirror the same
+// Solidity function signatures as the hand-written ABI JSON in
+// x/gravity/types (SignerSetTxCheckpointABIJSON, BatchTxCheckpointABIJSON,
+// ContractCallTxABIJSON), but are built once, directly as abi.Arguments,
+// instead of being re-parsed from JSON on every GetCheckpoint call. This
+// keeps checkpoint packing allocation-light and panic-free so it can be run
+// in tight loops such as relayer verification or slashing evidence replay.
+package checkpointabi
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func mustType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		// t is always one of the fixed type strings below; a failure here
+		// is a bug in this package, not in caller-supplied data.
+		panic(fmt.Sprintf("checkpointabi: invalid type %q: %v", t, err))
+	}
+	return typ
+}
+
+var (
+	typeBytes32    = mustType("bytes32")
+	typeUint256    = mustType("uint256")
+	typeAddress    = mustType("address")
+	typeAddressArr = mustType("address[]")
+	typeUint256Arr = mustType("uint256[]")
+	typeBytes      = mustType("bytes")
+)
+
+var signerSetCheckpointArgs = abi.Arguments{
+	{Type: typeBytes32},    // gravityID
+	{Type: typeBytes32},    // "checkpoint" method salt
+	{Type: typeUint256},    // nonce
+	{Type: typeAddressArr}, // validators
+	{Type: typeUint256Arr}, // powers
+}
+
+// PackSignerSetCheckpoint packs a SignerSetTx checkpoint. The returned
+// bytes are the exact abi.encode(...) output with no function selector
+// prefix, so callers hash them directly rather than discarding the first
+// 4 bytes.
+func PackSignerSetCheckpoint(gravityID, methodName [32]byte, nonce *big.Int, validators []gethcommon.Address, powers []*big.Int) ([]byte, error) {
+	return signerSetCheckpointArgs.Pack(gravityID, methodName, nonce, validators, powers)
+}
+
+var batchCheckpointArgs = abi.Arguments{
+	{Type: typeBytes32},    // gravityID
+	{Type: typeBytes32},    // "transactionBatch" method salt
+	{Type: typeUint256Arr}, // amounts
+	{Type: typeAddressArr}, // destinations
+	{Type: typeUint256Arr}, // fees
+	{Type: typeUint256},    // batchNonce
+	{Type: typeAddress},    // tokenContract
+	{Type: typeUint256},    // batchTimeout
+}
+
+// PackBatchCheckpoint packs a BatchTx checkpoint. The returned bytes are the
+// exact abi.encode(...) output with no function selector prefix.
+func PackBatchCheckpoint(gravityID, methodName [32]byte, amounts []*big.Int, destinations []gethcommon.Address, fees []*big.Int, batchNonce *big.Int, tokenContract gethcommon.Address, batchTimeout *big.Int) ([]byte, error) {
+	return batchCheckpointArgs.Pack(gravityID, methodName, amounts, destinations, fees, batchNonce, tokenContract, batchTimeout)
+}
+
+var logicCallCheckpointArgs = abi.Arguments{
+	{Type: typeBytes32},    // gravityID
+	{Type: typeBytes32},    // "logicCall" method salt
+	{Type: typeUint256Arr}, // transferAmounts
+	{Type: typeAddressArr}, // transferTokenContracts
+	{Type: typeUint256Arr}, // feeAmounts
+	{Type: typeAddressArr}, // feeTokenContracts
+	{Type: typeAddress},    // logicContractAddress
+	{Type: typeBytes},      // payload
+	{Type: typeUint256},    // timeout
+	{Type: typeBytes32},    // invalidationId
+	{Type: typeUint256},    // invalidationNonce
+}
+
+var signerSetBlsCheckpointArgs = abi.Arguments{
+	{Type: typeBytes32},    // gravityID
+	{Type: typeBytes32},    // "checkpoint-bls" method salt
+	{Type: typeUint256},    // nonce
+	{Type: typeAddressArr}, // validators
+	{Type: typeUint256Arr}, // powers
+	{Type: typeBytes},      // aggregateBlsPubkey
+	{Type: typeUint256},    // threshold
+}
+
+// PackSignerSetBlsCheckpoint packs the BLS-extended SignerSetTx checkpoint
+// carrying the aggregate BLS public key and signing threshold, tagged
+// distinctly from PackSignerSetCheckpoint so the two digests never collide.
+func PackSignerSetBlsCheckpoint(gravityID, methodName [32]byte, nonce *big.Int, validators []gethcommon.Address, powers []*big.Int, aggregateBlsPubkey []byte, threshold *big.Int) ([]byte, error) {
+	return signerSetBlsCheckpointArgs.Pack(gravityID, methodName, nonce, validators, powers, aggregateBlsPubkey, threshold)
+}
+
+// PackLogicCallCheckpoint packs a ContractCallTx checkpoint. The returned
+// bytes are the exact abi.encode(...) output with no function selector
+// prefix.
+func PackLogicCallCheckpoint(
+	gravityID, methodName [32]byte,
+	transferAmounts []*big.Int,
+	transferTokenContracts []gethcommon.Address,
+	feeAmounts []*big.Int,
+	feeTokenContracts []gethcommon.Address,
+	logicContractAddress gethcommon.Address,
+	payload []byte,
+	timeout *big.Int,
+	invalidationID [32]byte,
+	invalidationNonce *big.Int,
+) ([]byte, error) {
+	return logicCallCheckpointArgs.Pack(
+		gravityID,
+		methodName,
+		transferAmounts,
+		transferTokenContracts,
+		feeAmounts,
+		feeTokenContracts,
+		logicContractAddress,
+		payload,
+		timeout,
+		invalidationID,
+		invalidationNonce,
+	)
+}
@@ -0,0 +1,289 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// CheckpointEncoding selects the wire format used when an OutgoingTx is
+// packed into a checkpoint for validators to sign. This is a module param
+// so that a chain can switch encodings at a governance-controlled height
+// without breaking in-flight signatures produced under the old scheme.
+type CheckpointEncoding int32
+
+const (
+	// CheckpointEncoding_LegacyABI packs the checkpoint as
+	// keccak256(abi.encode(...)), matching the original Solidity Gravity.sol
+	// verifier. It is opaque to hardware wallets and signing UIs.
+	CheckpointEncoding_LegacyABI CheckpointEncoding = 0
+	// CheckpointEncoding_EIP712 produces an EIP-712 typed-data digest
+	// (0x1901 || domainSeparator || hashStruct(message)) so that wallets
+	// such as MetaMask and Ledger can display the checkpoint fields instead
+	// of an opaque hash.
+	CheckpointEncoding_EIP712 CheckpointEncoding = 1
+)
+
+func (e CheckpointEncoding) String() string {
+	switch e {
+	case CheckpointEncoding_LegacyABI:
+		return "LegacyABI"
+	case CheckpointEncoding_EIP712:
+		return "EIP712"
+	default:
+		return "Unknown"
+	}
+}
+
+// eip712DomainName is the EIP-712 "name" field shared by every Gravity
+// typed-data domain. The gravityID is carried in verifyingContract instead,
+// since it is the value that is actually bound on-chain by the Solidity
+// verifier.
+const eip712DomainName = "Gravity Bridge"
+
+// gravityTypedDataDomain builds the EIP-712 domain separator for a single
+// Gravity instance. Binding verifyingContract to the gravityID (rather than
+// a real contract address, which the Cosmos side does not know) ensures a
+// checkpoint signed for one bridge deployment can never be replayed as a
+// valid signature against another.
+func gravityTypedDataDomain(gravityID []byte, chainID *big.Int) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:              eip712DomainName,
+		Version:           "1",
+		ChainId:           math.NewHexOrDecimal256(chainID.Int64()),
+		VerifyingContract: gethcommon.BytesToAddress(gravityID).Hex(),
+	}
+}
+
+// typedDataDigest hashes a fully-populated apitypes.TypedData payload into
+// the standard EIP-712 digest: keccak256(0x1901 || domainSeparator ||
+// hashStruct(message)).
+func typedDataDigest(data apitypes.TypedData) ([]byte, error) {
+	domainSeparator, err := data.HashStruct("EIP712Domain", data.Domain.Map())
+	if err != nil {
+		return nil, fmt.Errorf("hashing EIP-712 domain: %w", err)
+	}
+	messageHash, err := data.HashStruct(data.PrimaryType, data.Message)
+	if err != nil {
+		return nil, fmt.Errorf("hashing EIP-712 message: %w", err)
+	}
+
+	rawData := append([]byte{0x19, 0x01}, append(domainSeparator, messageHash...)...)
+	return crypto.Keccak256(rawData), nil
+}
+
+// CheckpointEncodingForHeight resolves which encoding a checkpoint at
+// currentHeight should use given the module's configured encoding and the
+// height at which that encoding takes effect (EIP712StartHeight param).
+// Batches and logic calls already in flight at the switch height keep
+// verifying against the legacy ABI checkpoint they were signed under, since
+// their height is fixed at creation time and does not change retroactively.
+func CheckpointEncodingForHeight(encoding CheckpointEncoding, eip712StartHeight, currentHeight uint64) CheckpointEncoding {
+	if encoding == CheckpointEncoding_EIP712 && currentHeight >= eip712StartHeight {
+		return CheckpointEncoding_EIP712
+	}
+	return CheckpointEncoding_LegacyABI
+}
+
+// signerSetTxEIP712Types mirrors the SignerSetTx struct fields in the
+// Solidity Gravity contract, in the exact order they are ABI-encoded by the
+// legacy checkpoint so that both encodings commit to the same information.
+var signerSetTxEIP712Types = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"SignerSetTx": {
+		{Name: "nonce", Type: "uint256"},
+		{Name: "validators", Type: "address[]"},
+		{Name: "powers", Type: "uint256[]"},
+	},
+}
+
+// GetCheckpointEIP712 produces the EIP-712 typed-data digest for this
+// SignerSetTx, alongside the legacy ABI checkpoint returned by GetCheckpoint.
+func (u SignerSetTx) GetCheckpointEIP712(gravityID []byte, chainID *big.Int) ([]byte, error) {
+	if err := u.validateCheckpointInputs(); err != nil {
+		return nil, fmt.Errorf("signer set: %w", err)
+	}
+
+	validators := make([]string, len(u.Signers))
+	powers := make([]string, len(u.Signers))
+	for i, m := range u.Signers {
+		validators[i] = gethcommon.HexToAddress(m.EthereumAddress).Hex()
+		powers[i] = fmt.Sprintf("%d", m.Power)
+	}
+
+	data := apitypes.TypedData{
+		Types:       signerSetTxEIP712Types,
+		PrimaryType: "SignerSetTx",
+		Domain:      gravityTypedDataDomain(gravityID, chainID),
+		Message: apitypes.TypedDataMessage{
+			"nonce":      fmt.Sprintf("%d", u.Nonce),
+			"validators": validators,
+			"powers":     powers,
+		},
+	}
+	return typedDataDigest(data)
+}
+
+// batchTxEIP712Types mirrors the BatchTx struct fields in the Solidity
+// Gravity contract, in the same order as the legacy checkpoint.
+var batchTxEIP712Types = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"BatchTx": {
+		{Name: "amounts", Type: "uint256[]"},
+		{Name: "destinations", Type: "address[]"},
+		{Name: "fees", Type: "uint256[]"},
+		{Name: "batchNonce", Type: "uint256"},
+		{Name: "tokenContract", Type: "address"},
+		{Name: "batchTimeout", Type: "uint256"},
+	},
+}
+
+// GetCheckpointEIP712 produces the EIP-712 typed-data digest for this
+// BatchTx, alongside the legacy ABI checkpoint returned by GetCheckpoint.
+func (b BatchTx) GetCheckpointEIP712(gravityID []byte, chainID *big.Int) ([]byte, error) {
+	if err := b.validateCheckpointInputs(); err != nil {
+		return nil, fmt.Errorf("batch: %w", err)
+	}
+
+	amounts := make([]string, len(b.Transactions))
+	destinations := make([]string, len(b.Transactions))
+	fees := make([]string, len(b.Transactions))
+	for i, tx := range b.Transactions {
+		amounts[i] = tx.Erc20Token.Amount.String()
+		destinations[i] = gethcommon.HexToAddress(tx.EthereumRecipient).Hex()
+		fees[i] = tx.Erc20Fee.Amount.String()
+	}
+
+	data := apitypes.TypedData{
+		Types:       batchTxEIP712Types,
+		PrimaryType: "BatchTx",
+		Domain:      gravityTypedDataDomain(gravityID, chainID),
+		Message: apitypes.TypedDataMessage{
+			"amounts":       amounts,
+			"destinations":  destinations,
+			"fees":          fees,
+			"batchNonce":    fmt.Sprintf("%d", b.BatchNonce),
+			"tokenContract": gethcommon.HexToAddress(b.TokenContract).Hex(),
+			"batchTimeout":  fmt.Sprintf("%d", b.Timeout),
+		},
+	}
+	return typedDataDigest(data)
+}
+
+// contractCallTxEIP712Types mirrors the LogicCallTx struct fields in the
+// Solidity Gravity contract, in the same order as the legacy checkpoint.
+var contractCallTxEIP712Types = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"ContractCallTx": {
+		{Name: "transferAmounts", Type: "uint256[]"},
+		{Name: "transferTokenContracts", Type: "address[]"},
+		{Name: "feeAmounts", Type: "uint256[]"},
+		{Name: "feeTokenContracts", Type: "address[]"},
+		{Name: "logicContractAddress", Type: "address"},
+		{Name: "payload", Type: "bytes"},
+		{Name: "timeOut", Type: "uint256"},
+		{Name: "invalidationId", Type: "bytes32"},
+		{Name: "invalidationNonce", Type: "uint256"},
+	},
+}
+
+// GetCheckpointEIP712 produces the EIP-712 typed-data digest for this
+// ContractCallTx, alongside the legacy ABI checkpoint returned by
+// GetCheckpoint.
+func (c ContractCallTx) GetCheckpointEIP712(gravityID []byte, chainID *big.Int) ([]byte, error) {
+	if err := c.validateCheckpointInputs(); err != nil {
+		return nil, fmt.Errorf("logic call: %w", err)
+	}
+
+	transferAmounts := make([]string, len(c.Tokens))
+	transferTokenContracts := make([]string, len(c.Tokens))
+	for i, coin := range c.Tokens {
+		transferAmounts[i] = coin.Amount.String()
+		transferTokenContracts[i] = gethcommon.HexToAddress(coin.Contract).Hex()
+	}
+	feeAmounts := make([]string, len(c.Fees))
+	feeTokenContracts := make([]string, len(c.Fees))
+	for i, coin := range c.Fees {
+		feeAmounts[i] = coin.Amount.String()
+		feeTokenContracts[i] = gethcommon.HexToAddress(coin.Contract).Hex()
+	}
+
+	data := apitypes.TypedData{
+		Types:       contractCallTxEIP712Types,
+		PrimaryType: "ContractCallTx",
+		Domain:      gravityTypedDataDomain(gravityID, chainID),
+		Message: apitypes.TypedDataMessage{
+			"transferAmounts":        transferAmounts,
+			"transferTokenContracts": transferTokenContracts,
+			"feeAmounts":             feeAmounts,
+			"feeTokenContracts":      feeTokenContracts,
+			"logicContractAddress":   gethcommon.HexToAddress(c.Address).Hex(),
+			"payload":                hexutil.Encode(c.Payload),
+			"timeOut":                fmt.Sprintf("%d", c.Timeout),
+			"invalidationId":         hexutil.Bytes(c.InvalidationScope.Bytes()),
+			"invalidationNonce":      fmt.Sprintf("%d", c.InvalidationNonce),
+		},
+	}
+	return typedDataDigest(data)
+}
+
+// GetCheckpointForParams returns this SignerSetTx's checkpoint using
+// whichever encoding is active at currentHeight, per
+// params.CheckpointEncoding and params.EIP712StartHeight. This is the
+// production entry point the keeper calls when it needs a checkpoint for
+// validators to sign or verify; GetCheckpoint and GetCheckpointEIP712
+// remain available directly for callers (replay verification, tests) that
+// already know which encoding a given signature was produced under.
+//
+// A SignerSetTx carrying a BLS aggregate pubkey takes GetCheckpointBls
+// regardless of CheckpointEncoding: BLS aggregation and the
+// legacy-ABI/EIP-712 choice are orthogonal, and a signer set that has
+// adopted BLS is always verified with it.
+func (u SignerSetTx) GetCheckpointForParams(gravityID []byte, params Params, currentHeight uint64) ([]byte, error) {
+	if u.HasBlsAggregate() {
+		return u.GetCheckpointBls(gravityID)
+	}
+	if CheckpointEncodingForHeight(params.CheckpointEncoding, params.EIP712StartHeight, currentHeight) == CheckpointEncoding_EIP712 {
+		return u.GetCheckpointEIP712(gravityID, new(big.Int).SetUint64(params.EthChainID))
+	}
+	return u.GetCheckpoint(gravityID)
+}
+
+// GetCheckpointForParams returns this BatchTx's checkpoint using whichever
+// encoding is active at currentHeight. See SignerSetTx.GetCheckpointForParams.
+func (b BatchTx) GetCheckpointForParams(gravityID []byte, params Params, currentHeight uint64) ([]byte, error) {
+	if CheckpointEncodingForHeight(params.CheckpointEncoding, params.EIP712StartHeight, currentHeight) == CheckpointEncoding_EIP712 {
+		return b.GetCheckpointEIP712(gravityID, new(big.Int).SetUint64(params.EthChainID))
+	}
+	return b.GetCheckpoint(gravityID)
+}
+
+// GetCheckpointForParams returns this ContractCallTx's checkpoint using
+// whichever encoding is active at currentHeight. See
+// SignerSetTx.GetCheckpointForParams.
+func (c ContractCallTx) GetCheckpointForParams(gravityID []byte, params Params, currentHeight uint64) ([]byte, error) {
+	if CheckpointEncodingForHeight(params.CheckpointEncoding, params.EIP712StartHeight, currentHeight) == CheckpointEncoding_EIP712 {
+		return c.GetCheckpointEIP712(gravityID, new(big.Int).SetUint64(params.EthChainID))
+	}
+	return c.GetCheckpoint(gravityID)
+}
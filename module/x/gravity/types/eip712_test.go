@@ -0,0 +1,125 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestSignerSetTxGetCheckpointEIP712Stable(t *testing.T) {
+	sstx := SignerSetTx{
+		Nonce: 7,
+		Signers: []*BridgeValidator{
+			signer("0x2222222222222222222222222222222222222222", 100),
+			signer("0x1111111111111111111111111111111111111111", 50),
+		},
+	}
+	gravityID := []byte("gravity-test")
+	chainID := big.NewInt(1)
+
+	digest1, err := sstx.GetCheckpointEIP712(gravityID, chainID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	digest2, err := sstx.GetCheckpointEIP712(gravityID, chainID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(digest1) != 32 {
+		t.Fatalf("expected a 32 byte digest, got %d bytes", len(digest1))
+	}
+	if string(digest1) != string(digest2) {
+		t.Fatal("GetCheckpointEIP712 must be deterministic for identical inputs")
+	}
+}
+
+func TestSignerSetTxGetCheckpointEIP712RejectsInvalidSignerSet(t *testing.T) {
+	sstx := SignerSetTx{
+		Nonce: 1,
+		Signers: []*BridgeValidator{
+			signer("0x0000000000000000000000000000000000000000", 100),
+		},
+	}
+
+	if _, err := sstx.GetCheckpointEIP712([]byte("gravity-test"), big.NewInt(1)); err == nil {
+		t.Fatal("expected zero-address signer to be rejected before computing a digest")
+	}
+}
+
+// TestContractCallTxGetCheckpointEIP712 exercises the "payload"
+// (dynamic bytes) and "invalidationId" (bytes32) fields, which
+// apitypes rejects unless they're a hex string and a hexutil.Bytes
+// respectively rather than a bare []byte.
+func TestContractCallTxGetCheckpointEIP712(t *testing.T) {
+	call := ContractCallTx{
+		Address:           "0x1111111111111111111111111111111111111111",
+		Tokens:            []ERC20Token{{Contract: "0x2222222222222222222222222222222222222222", Amount: sdk.NewInt(100)}},
+		Fees:              []ERC20Token{{Contract: "0x2222222222222222222222222222222222222222", Amount: sdk.NewInt(1)}},
+		Payload:           []byte{0xde, 0xad, 0xbe, 0xef},
+		Timeout:           1000,
+		InvalidationScope: gethcommon.BigToHash(big.NewInt(1)),
+		InvalidationNonce: 1,
+	}
+
+	digest, err := call.GetCheckpointEIP712([]byte("gravity-test"), big.NewInt(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(digest) != 32 {
+		t.Fatalf("expected a 32 byte digest, got %d bytes", len(digest))
+	}
+}
+
+// TestBatchTxGetCheckpointEIP712 is the BatchTx analogue of
+// TestContractCallTxGetCheckpointEIP712, for completeness.
+func TestBatchTxGetCheckpointEIP712(t *testing.T) {
+	batch := BatchTx{
+		BatchNonce:    1,
+		TokenContract: "0x1111111111111111111111111111111111111111",
+		Timeout:       1000,
+		Transactions: []*OutgoingTransferTx{
+			{
+				Id:                1,
+				EthereumRecipient: "0x2222222222222222222222222222222222222222",
+				Erc20Token:        ERC20Token{Contract: "0x1111111111111111111111111111111111111111", Amount: sdk.NewInt(100)},
+				Erc20Fee:          ERC20Token{Contract: "0x1111111111111111111111111111111111111111", Amount: sdk.NewInt(1)},
+			},
+		},
+	}
+
+	digest, err := batch.GetCheckpointEIP712([]byte("gravity-test"), big.NewInt(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(digest) != 32 {
+		t.Fatalf("expected a 32 byte digest, got %d bytes", len(digest))
+	}
+}
+
+func TestCheckpointEncodingForHeight(t *testing.T) {
+	cases := []struct {
+		name       string
+		encoding   CheckpointEncoding
+		start      uint64
+		height     uint64
+		wantEip712 bool
+	}{
+		{"legacy configured", CheckpointEncoding_LegacyABI, 100, 200, false},
+		{"eip712 before switch height", CheckpointEncoding_EIP712, 100, 50, false},
+		{"eip712 at switch height", CheckpointEncoding_EIP712, 100, 100, true},
+		{"eip712 after switch height", CheckpointEncoding_EIP712, 100, 150, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := CheckpointEncodingForHeight(tc.encoding, tc.start, tc.height)
+			isEip712 := got == CheckpointEncoding_EIP712
+			if isEip712 != tc.wantEip712 {
+				t.Fatalf("got %s, want EIP712=%v", got, tc.wantEip712)
+			}
+		})
+	}
+}
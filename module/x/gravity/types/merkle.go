@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Domain-separation prefixes for the Merkle tree built over
+// MultiContractCallTx sub-call checkpoints. Hashing leaves and interior
+// nodes under distinct prefixes (rather than both as plain
+// keccak256(left||right)) prevents the classic second-preimage attack
+// where an interior node is crafted to also be accepted as a leaf, letting
+// an attacker forge a sub-call "included" in a batch it never signed for.
+const (
+	merkleLeafPrefix = byte(0x00)
+	merkleNodePrefix = byte(0x01)
+)
+
+// hashLeaf hashes a single sub-call checkpoint into a Merkle tree leaf.
+func hashLeaf(data []byte) []byte {
+	buf := make([]byte, 0, 1+len(data))
+	buf = append(buf, merkleLeafPrefix)
+	buf = append(buf, data...)
+	return crypto.Keccak256(buf)
+}
+
+// hashNode combines two child hashes into their parent, the pairwise
+// combination step used to build and verify a MultiContractCallTx's
+// Merkle checkpoint.
+func hashNode(left, right []byte) []byte {
+	buf := make([]byte, 0, 1+len(left)+len(right))
+	buf = append(buf, merkleNodePrefix)
+	buf = append(buf, left...)
+	buf = append(buf, right...)
+	return crypto.Keccak256(buf)
+}
+
+// merkleRoot computes a Merkle root over leaves by pairwise keccak256
+// hashing, duplicating the last node of any level with an odd number of
+// nodes so every node always has a sibling.
+func merkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	level := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		level[i] = hashLeaf(leaf)
+	}
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			next[i] = hashNode(level[2*i], level[2*i+1])
+		}
+		level = next
+	}
+	return level[0]
+}
+
+// merkleProof returns the sibling hashes needed to recompute
+// merkleRoot(leaves) from leaves[index], in bottom-up order.
+func merkleProof(leaves [][]byte, index int) ([][]byte, error) {
+	if index < 0 || index >= len(leaves) {
+		return nil, fmt.Errorf("index %d out of range for %d leaves", index, len(leaves))
+	}
+
+	var proof [][]byte
+	level := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		level[i] = hashLeaf(leaf)
+	}
+	idx := index
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+		proof = append(proof, level[idx^1])
+
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			next[i] = hashNode(level[2*i], level[2*i+1])
+		}
+		level = next
+		idx /= 2
+	}
+	return proof, nil
+}
+
+// verifyMerkleProof recomputes the Merkle root for leaf at index using
+// proof, and reports whether it matches root. This mirrors the
+// verification the Solidity submitLogicCallBatch(root, proof, call)
+// entrypoint performs to accept a single sub-call against a signed batch
+// checkpoint.
+func verifyMerkleProof(root, leaf []byte, index int, proof [][]byte) bool {
+	computed := hashLeaf(leaf)
+	idx := index
+	for _, sibling := range proof {
+		if idx%2 == 0 {
+			computed = hashNode(computed, sibling)
+		} else {
+			computed = hashNode(sibling, computed)
+		}
+		idx /= 2
+	}
+	return string(computed) == string(root)
+}
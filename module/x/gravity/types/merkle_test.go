@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func testLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = crypto.Keccak256([]byte{byte(i)})
+	}
+	return leaves
+}
+
+func TestMerkleProofRoundTrip(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 4, 7, 8, 9} {
+		leaves := testLeaves(n)
+		root := merkleRoot(leaves)
+
+		for i := 0; i < n; i++ {
+			proof, err := merkleProof(leaves, i)
+			if err != nil {
+				t.Fatalf("n=%d i=%d: unexpected error: %v", n, i, err)
+			}
+			if !verifyMerkleProof(root, leaves[i], i, proof) {
+				t.Fatalf("n=%d i=%d: proof did not verify against root", n, i)
+			}
+		}
+	}
+}
+
+func TestMerkleProofRejectsOutOfRangeIndex(t *testing.T) {
+	leaves := testLeaves(3)
+	if _, err := merkleProof(leaves, 3); err == nil {
+		t.Fatal("expected an error for an out-of-range index")
+	}
+	if _, err := merkleProof(leaves, -1); err == nil {
+		t.Fatal("expected an error for a negative index")
+	}
+}
+
+func TestHashLeafAndHashNodeAreDomainSeparated(t *testing.T) {
+	data := crypto.Keccak256([]byte("leaf"))
+	if string(hashLeaf(data)) == string(hashNode(data, data)) {
+		t.Fatal("leaf and node hashes must use distinct domain prefixes")
+	}
+}
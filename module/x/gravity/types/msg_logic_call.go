@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgSubmitLogicCall is submitted by an orchestrator to propose a new
+// outgoing ContractCallTx for the validator set to checkpoint and sign.
+type MsgSubmitLogicCall struct {
+	Orchestrator string         `json:"orchestrator"`
+	LogicCall    ContractCallTx `json:"logic_call"`
+}
+
+const TypeMsgSubmitLogicCall = "submit_logic_call"
+
+func NewMsgSubmitLogicCall(call ContractCallTx, orchestrator sdk.AccAddress) *MsgSubmitLogicCall {
+	return &MsgSubmitLogicCall{
+		Orchestrator: orchestrator.String(),
+		LogicCall:    call,
+	}
+}
+
+func (msg *MsgSubmitLogicCall) Route() string { return RouterKey }
+
+func (msg *MsgSubmitLogicCall) Type() string { return TypeMsgSubmitLogicCall }
+
+func (msg *MsgSubmitLogicCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(err, "orchestrator")
+	}
+	return nil
+}
+
+func (msg *MsgSubmitLogicCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgSubmitLogicCall) GetSigners() []sdk.AccAddress {
+	orchestrator, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{orchestrator}
+}
+
+// MsgSubmitMultiLogicCall is submitted by an orchestrator to propose a new
+// outgoing MultiContractCallTx for the validator set to checkpoint and
+// sign. See MsgSubmitLogicCall for the single-call case.
+type MsgSubmitMultiLogicCall struct {
+	Orchestrator   string              `json:"orchestrator"`
+	MultiLogicCall MultiContractCallTx `json:"multi_logic_call"`
+}
+
+const TypeMsgSubmitMultiLogicCall = "submit_multi_logic_call"
+
+func NewMsgSubmitMultiLogicCall(multi MultiContractCallTx, orchestrator sdk.AccAddress) *MsgSubmitMultiLogicCall {
+	return &MsgSubmitMultiLogicCall{
+		Orchestrator:   orchestrator.String(),
+		MultiLogicCall: multi,
+	}
+}
+
+func (msg *MsgSubmitMultiLogicCall) Route() string { return RouterKey }
+
+func (msg *MsgSubmitMultiLogicCall) Type() string { return TypeMsgSubmitMultiLogicCall }
+
+func (msg *MsgSubmitMultiLogicCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(err, "orchestrator")
+	}
+	return nil
+}
+
+func (msg *MsgSubmitMultiLogicCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgSubmitMultiLogicCall) GetSigners() []sdk.AccAddress {
+	orchestrator, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{orchestrator}
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MultiContractCallTxPrefixByte is the prefix byte for MultiContractCallTx,
+// next to ContractCallTxPrefixByte. GetCheckpoint does not pack it into the
+// checkpoint, which is the bare Merkle root over the sub-call checkpoints.
+const MultiContractCallTxPrefixByte = ContractCallTxPrefixByte + 1
+
+// MultiContractCallTx authorizes a batch of logic calls to execute
+// atomically on Ethereum under a single validator signature. Its checkpoint
+// is a Merkle root over each sub-call's own ContractCallTx checkpoint,
+// rather than one large ABI-encoded blob, so a relayer can submit
+// individual sub-calls plus a GetSubCallProof proof to the Solidity
+// submitLogicCallBatch(root, proof, call) entrypoint instead of replaying
+// the whole batch at once.
+type MultiContractCallTx struct {
+	Height            uint64
+	InvalidationScope []byte
+	InvalidationNonce uint64
+	SubCalls          []ContractCallTx
+}
+
+var _ OutgoingTx = &MultiContractCallTx{}
+
+func (m *MultiContractCallTx) GetStoreIndex() []byte {
+	return MakeContractCallTxKey(m.InvalidationScope, m.InvalidationNonce)
+}
+
+func (m *MultiContractCallTx) GetCosmosHeight() uint64 {
+	return m.Height
+}
+
+// subCallLeaves computes each sub-call's ordinary ContractCallTx
+// checkpoint; these are the Merkle tree's leaves.
+func (m MultiContractCallTx) subCallLeaves(gravityID []byte) ([][]byte, error) {
+	if len(m.SubCalls) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "multi contract call tx has no sub-calls")
+	}
+
+	leaves := make([][]byte, len(m.SubCalls))
+	for i, sub := range m.SubCalls {
+		checkpoint, err := sub.GetCheckpoint(gravityID)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "sub-call %d", i)
+		}
+		leaves[i] = checkpoint
+	}
+	return leaves, nil
+}
+
+// GetCheckpoint returns the Merkle root over every sub-call's checkpoint,
+// using keccak256 pairwise hashing with duplication of odd trailing leaves.
+// A validator signing this single digest authorizes every sub-call in the
+// batch.
+func (m MultiContractCallTx) GetCheckpoint(gravityID []byte) ([]byte, error) {
+	leaves, err := m.subCallLeaves(gravityID)
+	if err != nil {
+		return nil, err
+	}
+	return merkleRoot(leaves), nil
+}
+
+// ValidatePayloadSize enforces the MaxLogicCallPayloadBytes module param
+// against every sub-call's Payload. See ContractCallTx.ValidatePayloadSize.
+func (m MultiContractCallTx) ValidatePayloadSize(maxLogicCallPayloadBytes uint64) error {
+	for i, sub := range m.SubCalls {
+		if err := sub.ValidatePayloadSize(maxLogicCallPayloadBytes); err != nil {
+			return sdkerrors.Wrapf(err, "sub-call %d", i)
+		}
+	}
+	return nil
+}
+
+// GetSubCallProof returns the Merkle proof for the sub-call at index, which
+// a relayer submits alongside that sub-call to authorize executing it
+// without replaying the rest of the batch.
+func (m MultiContractCallTx) GetSubCallProof(gravityID []byte, index int) ([][]byte, error) {
+	leaves, err := m.subCallLeaves(gravityID)
+	if err != nil {
+		return nil, err
+	}
+	return merkleProof(leaves, index)
+}
@@ -2,12 +2,11 @@ package types
 
 import (
 	"math/big"
-	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types/checkpointabi"
 )
 
 var (
@@ -60,21 +59,21 @@ func (cctx *ContractCallTx) GetCosmosHeight() uint64 {
 // GetCheckpoint //
 ///////////////////
 
-// GetCheckpoint returns the checkpoint
-func (u SignerSetTx) GetCheckpoint(gravityID []byte) []byte {
-	// error case here should not occur outside of testing since the above is a constant
-	contractAbi, err := abi.JSON(strings.NewReader(SignerSetTxCheckpointABIJSON))
-	if err != nil {
-		panic(err)
+// GetCheckpoint returns the checkpoint. It returns an error instead of
+// panicking if the signer set is malformed (duplicate or zero addresses,
+// bad ordering) or gravityID does not fit in 32 bytes, since either can
+// arrive from untrusted input on the way to consensus-critical code.
+func (u SignerSetTx) GetCheckpoint(gravityID []byte) ([]byte, error) {
+	if err := u.validateCheckpointInputs(); err != nil {
+		return nil, sdkerrors.Wrap(err, "signer set")
 	}
 
 	// the contract argument is not a arbitrary length array but a fixed length 32 byte
 	// array, therefore we have to utf8 encode the string (the default in this case) and
-	// then copy the variable length encoded data into a fixed length array. This function
-	// will panic if gravityId is too long to fit in 32 bytes
+	// then copy the variable length encoded data into a fixed length array.
 	gravityIDFixed, err := byteArrayToFixByteArray(gravityID)
 	if err != nil {
-		panic(err)
+		return nil, sdkerrors.Wrap(err, "gravityID")
 	}
 
 	checkpointBytes := []uint8("checkpoint")
@@ -87,46 +86,40 @@ func (u SignerSetTx) GetCheckpoint(gravityID []byte) []byte {
 		memberAddresses[i] = gethcommon.HexToAddress(m.EthereumAddress)
 		convertedPowers[i] = big.NewInt(int64(m.Power))
 	}
-	// the word 'checkpoint' needs to be the same as the 'name' above in the checkpointAbiJson
-	// but other than that it's a constant that has no impact on the output. This is because
-	// it gets encoded as a function name which we must then discard.
-	bytes, packErr := contractAbi.Pack(
-		"checkpoint",
+
+	// PackSignerSetCheckpoint uses pre-compiled ABI bindings instead of
+	// parsing SignerSetTxCheckpointABIJSON on every call, and returns the
+	// exact abi.encode(...) bytes with no selector prefix to discard.
+	bytes, err := checkpointabi.PackSignerSetCheckpoint(
 		gravityIDFixed,
 		checkpoint,
 		big.NewInt(int64(u.Nonce)),
 		memberAddresses,
 		convertedPowers,
 	)
-
-	// this should never happen outside of test since any case that could crash on encoding
-	// should be filtered above.
-	if packErr != nil {
-		panic(packErr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "packing checkpoint")
 	}
 
-	// we hash the resulting encoded bytes discarding the first 4 bytes these 4 bytes are the constant
-	// method name 'checkpoint'. If you where to replace the checkpoint constant in this code you would
-	// then need to adjust how many bytes you truncate off the front to get the output of abi.encode()
-	hash := crypto.Keccak256Hash(bytes[4:])
-	return hash.Bytes()
+	hash := crypto.Keccak256Hash(bytes)
+	return hash.Bytes(), nil
 }
 
-// GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
-func (b BatchTx) GetCheckpoint(gravityID []byte) []byte {
-
-	encodedBatch, err := abi.JSON(strings.NewReader(BatchTxCheckpointABIJSON))
-	if err != nil {
-		panic(sdkerrors.Wrap(err, "bad ABI definition in code"))
+// GetCheckpoint gets the checkpoint signature from the given outgoing tx
+// batch. It returns an error instead of panicking if the batch contains
+// duplicate transaction IDs or zero-amount transfers, or gravityID does
+// not fit in 32 bytes.
+func (b BatchTx) GetCheckpoint(gravityID []byte) ([]byte, error) {
+	if err := b.validateCheckpointInputs(); err != nil {
+		return nil, sdkerrors.Wrap(err, "batch")
 	}
 
 	// the contract argument is not a arbitrary length array but a fixed length 32 byte
 	// array, therefore we have to utf8 encode the string (the default in this case) and
-	// then copy the variable length encoded data into a fixed length array. This function
-	// will panic if gravityId is too long to fit in 32 bytes
+	// then copy the variable length encoded data into a fixed length array.
 	gravityIDFixed, err := byteArrayToFixByteArray(gravityID)
 	if err != nil {
-		panic(err)
+		return nil, sdkerrors.Wrap(err, "gravityID")
 	}
 
 	// Create the methodName argument which salts the signature
@@ -144,11 +137,10 @@ func (b BatchTx) GetCheckpoint(gravityID []byte) []byte {
 		txFees[i] = tx.Erc20Fee.Amount.BigInt()
 	}
 
-	// the methodName needs to be the same as the 'name' above in the checkpointAbiJson
-	// but other than that it's a constant that has no impact on the output. This is because
-	// it gets encoded as a function name which we must then discard.
-	abiEncodedBatch, err := encodedBatch.Pack(
-		"submitBatch",
+	// PackBatchCheckpoint uses pre-compiled ABI bindings instead of parsing
+	// BatchTxCheckpointABIJSON on every call, and returns the exact
+	// abi.encode(...) bytes with no selector prefix to discard.
+	abiEncodedBatch, err := checkpointabi.PackBatchCheckpoint(
 		gravityIDFixed,
 		batchMethodName,
 		txAmounts,
@@ -158,25 +150,20 @@ func (b BatchTx) GetCheckpoint(gravityID []byte) []byte {
 		gethcommon.HexToAddress(b.TokenContract),
 		big.NewInt(int64(b.Timeout)),
 	)
-
-	// this should never happen outside of test since any case that could crash on encoding
-	// should be filtered above.
 	if err != nil {
-		panic(sdkerrors.Wrap(err, "packing checkpoint"))
+		return nil, sdkerrors.Wrap(err, "packing checkpoint")
 	}
 
-	// we hash the resulting encoded bytes discarding the first 4 bytes these 4 bytes are the constant
-	// method name 'checkpoint'. If you where to replace the checkpoint constant in this code you would
-	// then need to adjust how many bytes you truncate off the front to get the output of encodedBatch.encode()
-	return crypto.Keccak256Hash(abiEncodedBatch[4:]).Bytes()
+	return crypto.Keccak256Hash(abiEncodedBatch).Bytes(), nil
 }
 
-// GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
-func (c ContractCallTx) GetCheckpoint(gravityID []byte) []byte {
-
-	encodedCall, err := abi.JSON(strings.NewReader(ContractCallTxABIJSON))
-	if err != nil {
-		panic(sdkerrors.Wrap(err, "bad ABI definition in code"))
+// GetCheckpoint gets the checkpoint signature from the given outgoing tx
+// logic call. It returns an error instead of panicking if Tokens or Fees
+// contain a duplicate token contract, or gravityID does not fit in 32
+// bytes.
+func (c ContractCallTx) GetCheckpoint(gravityID []byte) ([]byte, error) {
+	if err := c.validateCheckpointInputs(); err != nil {
+		return nil, sdkerrors.Wrap(err, "logic call")
 	}
 
 	// Create the methodName argument which salts the signature
@@ -186,11 +173,10 @@ func (c ContractCallTx) GetCheckpoint(gravityID []byte) []byte {
 
 	// the contract argument is not a arbitrary length array but a fixed length 32 byte
 	// array, therefore we have to utf8 encode the string (the default in this case) and
-	// then copy the variable length encoded data into a fixed length array. This function
-	// will panic if gravityId is too long to fit in 32 bytes
+	// then copy the variable length encoded data into a fixed length array.
 	gravityIDFixed, err := byteArrayToFixByteArray(gravityID)
 	if err != nil {
-		panic(err)
+		return nil, sdkerrors.Wrap(err, "gravityID")
 	}
 
 	// Run through the elements of the logic call and serialize them
@@ -211,11 +197,10 @@ func (c ContractCallTx) GetCheckpoint(gravityID []byte) []byte {
 	var invalidationId [32]byte
 	copy(invalidationId[:], c.InvalidationScope[:])
 
-	// the methodName needs to be the same as the 'name' above in the checkpointAbiJson
-	// but other than that it's a constant that has no impact on the output. This is because
-	// it gets encoded as a function name which we must then discard.
-	abiEncodedCall, err := encodedCall.Pack(
-		"checkpoint",
+	// PackLogicCallCheckpoint uses pre-compiled ABI bindings instead of
+	// parsing ContractCallTxABIJSON on every call, and returns the exact
+	// abi.encode(...) bytes with no selector prefix to discard.
+	abiEncodedCall, err := checkpointabi.PackLogicCallCheckpoint(
 		gravityIDFixed,
 		logicCallMethodName,
 		transferAmounts,
@@ -228,12 +213,9 @@ func (c ContractCallTx) GetCheckpoint(gravityID []byte) []byte {
 		invalidationId,
 		big.NewInt(int64(c.InvalidationNonce)),
 	)
-
-	// this should never happen outside of test since any case that could crash on encoding
-	// should be filtered above.
 	if err != nil {
-		panic(sdkerrors.Wrap(err, "packing checkpoint"))
+		return nil, sdkerrors.Wrap(err, "packing checkpoint")
 	}
 
-	return crypto.Keccak256Hash(abiEncodedCall[4:]).Bytes()
+	return crypto.Keccak256Hash(abiEncodedCall).Bytes(), nil
 }
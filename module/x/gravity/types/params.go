@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Params holds the module parameters relevant to checkpoint packing. The
+// full module Params additionally carries signer set/batch/slashing
+// windows etc.; only the checkpoint-related fields are modeled here.
+type Params struct {
+	// MaxLogicCallPayloadBytes bounds a single ContractCallTx's Payload.
+	MaxLogicCallPayloadBytes uint64
+	// CheckpointEncoding selects the checkpoint wire format new outgoing
+	// txs should use once EIP712StartHeight is reached.
+	CheckpointEncoding CheckpointEncoding
+	// EIP712StartHeight is the Cosmos block height at which
+	// CheckpointEncoding_EIP712 takes effect, per CheckpointEncodingForHeight.
+	// Batches and logic calls created before this height keep verifying
+	// against the legacy ABI checkpoint they were originally signed under.
+	EIP712StartHeight uint64
+	// EthChainID is the EIP-155 chain ID of the Ethereum network this
+	// Gravity instance bridges to, used as the EIP-712 domain's chainId.
+	EthChainID uint64
+}
+
+// DefaultParams returns the default checkpoint-related module params:
+// legacy ABI encoding, with no EIP-712 switch height configured yet.
+func DefaultParams() Params {
+	return Params{
+		MaxLogicCallPayloadBytes: DefaultMaxLogicCallPayloadBytes,
+		CheckpointEncoding:       CheckpointEncoding_LegacyABI,
+		EIP712StartHeight:        0,
+	}
+}
+
+// DefaultMaxLogicCallPayloadBytes is the default value of the
+// MaxLogicCallPayloadBytes module param, bounding how large a single
+// ContractCallTx's Payload may be so that one logic call cannot blow up
+// gossip bandwidth or state size.
+const DefaultMaxLogicCallPayloadBytes uint64 = 1 << 16 // 64 KiB
+
+// ValidatePayloadSize enforces the MaxLogicCallPayloadBytes module param
+// against this ContractCallTx's Payload. It is checked at msg-handler time,
+// when the logic call is first submitted, rather than from GetCheckpoint,
+// since the bound comes from a module param rather than the tx's own
+// contents.
+func (c ContractCallTx) ValidatePayloadSize(maxLogicCallPayloadBytes uint64) error {
+	if uint64(len(c.Payload)) > maxLogicCallPayloadBytes {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "logic call payload of %d bytes exceeds max of %d", len(c.Payload), maxLogicCallPayloadBytes)
+	}
+	return nil
+}